@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"Aicon-assignment/internal/cron"
+	"Aicon-assignment/internal/infrastructure/memory"
+	adminController "Aicon-assignment/internal/interfaces/controller/admin"
+	itemController "Aicon-assignment/internal/interfaces/controller/items"
+	grpctransport "Aicon-assignment/internal/transport/grpc"
+	"Aicon-assignment/internal/transport/grpc/pb"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	itemUsecase := usecase.NewItemUsecase(newItemRepository())
+
+	cronManager := cron.NewManager(nil)
+	summaryCache := &cron.SummaryCache{}
+	if err := cronManager.AddTask("category-summary-cache", "@every 60s", cron.NewCategorySummaryCacheJob(itemUsecase, summaryCache)); err != nil {
+		log.Fatalf("failed to register category-summary-cache job: %v", err)
+	}
+	if err := cronManager.AddTask("stale-item-scan", "@every 24h", cron.NewStaleItemScanJob(itemUsecase, 30*24*time.Hour)); err != nil {
+		log.Fatalf("failed to register stale-item-scan job: %v", err)
+	}
+	if err := cronManager.AddTask("category-metrics", "@every 5m", cron.NewCategoryMetricsJob(itemUsecase)); err != nil {
+		log.Fatalf("failed to register category-metrics job: %v", err)
+	}
+	cronManager.Start(ctx)
+
+	e := echo.New()
+	itemHandler := itemController.NewItemHandler(itemUsecase)
+	summaryHandler := itemController.NewCategorySummaryHandler(summaryCache)
+	jobsHandler := adminController.NewJobsHandler(cronManager)
+	e.GET("/items", itemHandler.ListItems)
+	e.GET("/items/:id", itemHandler.GetItemByID)
+	e.POST("/items", itemHandler.CreateItem)
+	e.PATCH("/items/:id", itemHandler.UpdateItem)
+	e.DELETE("/items/:id", itemHandler.DeleteItem)
+	e.DELETE("/items", itemHandler.DeleteItems)
+	e.GET("/items/summary", summaryHandler.GetCategorySummary)
+	e.GET("/admin/jobs", jobsHandler.GetJobs)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterItemServiceServer(grpcServer, grpctransport.NewItemServer(itemUsecase))
+	if os.Getenv("ENV") != "production" {
+		reflection.Register(grpcServer)
+	}
+
+	go func() {
+		if err := e.Start(":8080"); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("http server failed: %v", err)
+		}
+	}()
+
+	go func() {
+		lis, err := net.Listen("tcp", ":9090")
+		if err != nil {
+			log.Fatalf("failed to listen for grpc: %v", err)
+		}
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("grpc server failed: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_ = e.Shutdown(shutdownCtx)
+	grpcServer.GracefulStop()
+}
+
+// newItemRepository wires the concrete ItemRepository implementation. This
+// is the in-memory implementation so the server is runnable out of the box;
+// swap this out for a real datastore-backed constructor when one is wired
+// in.
+func newItemRepository() usecase.ItemRepository {
+	return memory.NewItemRepository()
+}
@@ -0,0 +1,28 @@
+package cron
+
+import "time"
+
+// Clock abstracts ticker creation so tests can drive the scheduler with a
+// fake ticker instead of waiting on real time.
+type Clock interface {
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker abstracts *time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+type realClock struct{}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{t: time.NewTicker(d)}
+}
+
+type realTicker struct {
+	t *time.Ticker
+}
+
+func (r *realTicker) C() <-chan time.Time { return r.t.C }
+func (r *realTicker) Stop()               { r.t.Stop() }
@@ -0,0 +1,83 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// SummaryCache holds the most recently computed category summary so
+// /items/summary can serve from cache instead of hitting the repository on
+// every request.
+type SummaryCache struct {
+	mu      sync.RWMutex
+	summary *usecase.CategorySummary
+	asOf    time.Time
+}
+
+// Get returns the cached summary, when it was computed, and whether one
+// has been computed yet at all.
+func (c *SummaryCache) Get() (summary *usecase.CategorySummary, asOf time.Time, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.summary, c.asOf, c.summary != nil
+}
+
+func (c *SummaryCache) set(summary *usecase.CategorySummary, asOf time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.summary = summary
+	c.asOf = asOf
+}
+
+// NewCategorySummaryCacheJob returns a task that recomputes itemUsecase's
+// category summary into cache on every tick.
+func NewCategorySummaryCacheJob(itemUsecase usecase.ItemUsecase, cache *SummaryCache) TaskFunc {
+	return func(ctx context.Context) error {
+		summary, err := itemUsecase.GetCategorySummary(ctx)
+		if err != nil {
+			return err
+		}
+		cache.set(summary, time.Now())
+		return nil
+	}
+}
+
+// NewStaleItemScanJob returns a task that logs items that haven't been
+// updated in over staleAfter, as a nightly hygiene check.
+func NewStaleItemScanJob(itemUsecase usecase.ItemUsecase, staleAfter time.Duration) TaskFunc {
+	return func(ctx context.Context) error {
+		items, err := itemUsecase.GetAllItems(ctx)
+		if err != nil {
+			return err
+		}
+
+		cutoff := time.Now().Add(-staleAfter)
+		for _, item := range items {
+			if item.UpdatedAt.Before(cutoff) {
+				log.Printf("cron: item %d has not been updated since %s", item.ID, item.UpdatedAt.Format(time.RFC3339))
+			}
+		}
+		return nil
+	}
+}
+
+// NewCategoryMetricsJob returns a task that logs the per-category item
+// count on every tick, suitable for scraping from logs until a metrics
+// backend is wired in.
+func NewCategoryMetricsJob(itemUsecase usecase.ItemUsecase) TaskFunc {
+	return func(ctx context.Context) error {
+		summary, err := itemUsecase.GetCategorySummary(ctx)
+		if err != nil {
+			return err
+		}
+
+		for category, count := range summary.Categories {
+			log.Printf("cron: category=%s count=%d", category, count)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TaskFunc is the unit of work a scheduled task performs. Returning an
+// error records it on the task's Status; it does not stop the scheduler.
+type TaskFunc func(ctx context.Context) error
+
+// Status reports the last-known outcome of a scheduled task.
+type Status struct {
+	LastRunAt       time.Time
+	LastCompletedAt time.Time
+	LastError       error
+	Running         bool
+}
+
+type task struct {
+	name     string
+	interval time.Duration
+	fn       TaskFunc
+
+	// guard records isRunning/lastCompletedTime for Status() reporting.
+	// It is not what protects against overlap: this task's single
+	// per-task goroutine (see Manager.Start) only ever calls run
+	// synchronously and drains any tick that arrives mid-run, so two
+	// calls to run can never actually be in flight at once.
+	guard sync.Map // "isRunning" -> bool, "lastCompletedTime" -> time.Time
+
+	mu     sync.Mutex
+	status Status
+}
+
+func (t *task) run(ctx context.Context) {
+	t.guard.Store("isRunning", true)
+	t.mu.Lock()
+	t.status.Running = true
+	t.status.LastRunAt = time.Now()
+	t.mu.Unlock()
+
+	err := t.fn(ctx)
+
+	completedAt := time.Now()
+	t.guard.Store("lastCompletedTime", completedAt)
+	t.guard.Store("isRunning", false)
+
+	t.mu.Lock()
+	t.status.Running = false
+	t.status.LastCompletedAt = completedAt
+	t.status.LastError = err
+	t.mu.Unlock()
+}
+
+// Manager schedules TaskFuncs to run on a fixed interval and tracks their
+// last-run outcome for observability.
+type Manager struct {
+	clock Clock
+
+	mu    sync.Mutex
+	tasks []*task
+	wg    sync.WaitGroup
+}
+
+// NewManager creates a Manager. A nil clock uses real tickers; tests can
+// pass a fake Clock to control time explicitly.
+func NewManager(clock Clock) *Manager {
+	if clock == nil {
+		clock = realClock{}
+	}
+	return &Manager{clock: clock}
+}
+
+// AddTask registers fn to run every time the schedule described by spec
+// elapses. Only the "@every <duration>" form is currently supported, e.g.
+// "@every 60s".
+func (m *Manager) AddTask(name, spec string, fn TaskFunc) error {
+	interval, err := parseSpec(spec)
+	if err != nil {
+		return fmt.Errorf("cron: invalid schedule %q for task %q: %w", spec, name, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tasks = append(m.tasks, &task{name: name, interval: interval, fn: fn})
+	return nil
+}
+
+func parseSpec(spec string) (time.Duration, error) {
+	const prefix = "@every "
+	if !strings.HasPrefix(spec, prefix) {
+		return 0, fmt.Errorf("unsupported schedule %q, expected \"@every <duration>\"", spec)
+	}
+	return time.ParseDuration(strings.TrimPrefix(spec, prefix))
+}
+
+// Start launches every registered task on its own ticker and returns
+// immediately; the tasks keep running until ctx is cancelled. Use Wait to
+// block for shutdown.
+func (m *Manager) Start(ctx context.Context) {
+	m.mu.Lock()
+	tasks := append([]*task(nil), m.tasks...)
+	m.mu.Unlock()
+
+	for _, t := range tasks {
+		t := t
+		ticker := m.clock.NewTicker(t.interval)
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C():
+					t.run(ctx)
+					// A tick that arrived while the run above was in
+					// flight is sitting in ticker's one-slot buffer now;
+					// drain and discard it here rather than looping back
+					// to select, where it would look like a fresh tick
+					// and trigger an immediate second, overlapping run.
+					select {
+					case <-ticker.C():
+					default:
+					}
+				}
+			}
+		}()
+	}
+}
+
+// Wait blocks until every task goroutine started by Start has returned,
+// i.e. until the context passed to Start is cancelled.
+func (m *Manager) Wait() {
+	m.wg.Wait()
+}
+
+// Status returns the last-run outcome of every registered task, keyed by
+// task name, for a /admin/jobs style endpoint.
+func (m *Manager) Status() map[string]Status {
+	m.mu.Lock()
+	tasks := append([]*task(nil), m.tasks...)
+	m.mu.Unlock()
+
+	statuses := make(map[string]Status, len(tasks))
+	for _, t := range tasks {
+		t.mu.Lock()
+		statuses[t.name] = t.status
+		t.mu.Unlock()
+	}
+	return statuses
+}
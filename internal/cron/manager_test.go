@@ -0,0 +1,102 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeTicker struct {
+	ch chan time.Time
+}
+
+func (f *fakeTicker) C() <-chan time.Time { return f.ch }
+func (f *fakeTicker) Stop()               {}
+
+type fakeClock struct {
+	tickers []*fakeTicker
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) Ticker {
+	t := &fakeTicker{ch: make(chan time.Time, 1)}
+	c.tickers = append(c.tickers, t)
+	return t
+}
+
+func TestManager_AddTask_RejectsUnsupportedSpec(t *testing.T) {
+	m := NewManager(nil)
+
+	err := m.AddTask("bad", "0 0 * * *", func(ctx context.Context) error { return nil })
+
+	if err == nil {
+		t.Fatal("expected an error for an unsupported schedule spec")
+	}
+}
+
+func TestManager_OverlappingRunsAreSkipped(t *testing.T) {
+	clock := &fakeClock{}
+	m := NewManager(clock)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls int32
+
+	if err := m.AddTask("slow", "@every 10ms", func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		started <- struct{}{}
+		<-release
+		return nil
+	}); err != nil {
+		t.Fatalf("AddTask returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	ticker := clock.tickers[0]
+	ticker.ch <- time.Now() // first tick starts the slow task
+	<-started
+
+	ticker.ch <- time.Now() // second tick arrives while the first run is in flight
+
+	release <- struct{}{}
+	time.Sleep(10 * time.Millisecond) // let a wrongly-accepted second run settle
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected the overlapping tick to be skipped, fn ran %d times", got)
+	}
+}
+
+func TestManager_Status_RecordsLastErrorAndCompletion(t *testing.T) {
+	clock := &fakeClock{}
+	m := NewManager(clock)
+
+	wantErr := errors.New("boom")
+	done := make(chan struct{})
+
+	if err := m.AddTask("failing", "@every 10ms", func(ctx context.Context) error {
+		defer close(done)
+		return wantErr
+	}); err != nil {
+		t.Fatalf("AddTask returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.Start(ctx)
+
+	clock.tickers[0].ch <- time.Now()
+	<-done
+	time.Sleep(5 * time.Millisecond) // let the task finish recording its status
+
+	status := m.Status()["failing"]
+	if status.LastError == nil || status.LastError.Error() != "boom" {
+		t.Fatalf("expected last error to be recorded, got=%v", status.LastError)
+	}
+	if status.Running {
+		t.Fatal("expected task to no longer be marked running once it completes")
+	}
+}
@@ -0,0 +1,271 @@
+// Package memory provides an in-process ItemRepository implementation.
+// It exists so cmd/server can start without a real datastore configured;
+// swap it out for the production implementation (SQL, etc.) when one is
+// wired in.
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+)
+
+// ItemRepository is a mutex-guarded, map-backed usecase.ItemRepository.
+// It has no persistence across restarts and is not meant for production
+// use beyond letting the server boot and be exercised end to end.
+type ItemRepository struct {
+	mu     sync.Mutex
+	items  map[int64]*entity.Item
+	nextID int64
+}
+
+// NewItemRepository creates an empty in-memory ItemRepository.
+func NewItemRepository() *ItemRepository {
+	return &ItemRepository{items: make(map[int64]*entity.Item)}
+}
+
+func (r *ItemRepository) FindAll(ctx context.Context) ([]*entity.Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := make([]*entity.Item, 0, len(r.items))
+	for _, item := range r.items {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items, nil
+}
+
+func (r *ItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	item, ok := r.items[id]
+	if !ok {
+		return nil, domainErrors.ErrItemNotFound
+	}
+	return item, nil
+}
+
+func (r *ItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	item.ID = r.nextID
+	item.CreatedAt = time.Now()
+	item.UpdatedAt = item.CreatedAt
+	r.items[item.ID] = item
+	return item, nil
+}
+
+func (r *ItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.items[item.ID]; !ok {
+		return domainErrors.ErrItemNotFound
+	}
+	r.items[item.ID] = item
+	return nil
+}
+
+func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.items[id]; !ok {
+		return domainErrors.ErrItemNotFound
+	}
+	delete(r.items, id)
+	return nil
+}
+
+func (r *ItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	summary := make(map[string]int)
+	for _, item := range r.items {
+		summary[item.Category]++
+	}
+	return summary, nil
+}
+
+// DeleteItems deletes every id in ids, reporting the outcome of each
+// individually so a batch can partially succeed.
+func (r *ItemRepository) DeleteItems(ctx context.Context, ids []int64) ([]usecase.ItemDeleteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := make([]usecase.ItemDeleteResult, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := r.items[id]; !ok {
+			results = append(results, usecase.ItemDeleteResult{ID: id, Error: domainErrors.ErrItemNotFound})
+			continue
+		}
+		delete(r.items, id)
+		results = append(results, usecase.ItemDeleteResult{ID: id})
+	}
+	return results, nil
+}
+
+func (r *ItemRepository) DeleteByFilter(ctx context.Context, filter usecase.ItemFilter) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for id, item := range r.items {
+		if !matchesFilter(item, filter) {
+			continue
+		}
+		delete(r.items, id)
+		count++
+	}
+	return count, nil
+}
+
+func (r *ItemRepository) ListItems(ctx context.Context, input usecase.ListItemsInput, after *usecase.ItemCursor) ([]*entity.Item, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Filtering on the cursor tuple here, rather than resolving it to a
+	// row index first, is what makes this a real keyset query: it doesn't
+	// matter whether the row after.ID pointed to is still in the table.
+	matched := make([]*entity.Item, 0, len(r.items))
+	for _, item := range r.items {
+		if !matchesListFilter(item, input) {
+			continue
+		}
+		if after != nil && !isAfterCursor(item, after, input.SortBy, input.SortDir) {
+			continue
+		}
+		matched = append(matched, item)
+	}
+
+	less := sortLess(input.SortBy, input.SortDir)
+	sort.Slice(matched, func(i, j int) bool { return less(matched[i], matched[j]) })
+
+	hasMore := len(matched) > input.Limit
+	if hasMore {
+		matched = matched[:input.Limit]
+	}
+	return matched, hasMore, nil
+}
+
+// isAfterCursor reports whether item sorts strictly after after under the
+// (sort_key, id) ordering sortBy/sortDir define, i.e. whether it belongs on
+// the page following the one after was cut from. The id half of the tuple
+// always breaks ties in ascending order, matching nextCursorFor/sortLess.
+func isAfterCursor(item *entity.Item, after *usecase.ItemCursor, sortBy usecase.SortField, sortDir usecase.SortDirection) bool {
+	desc := sortDir == usecase.SortDesc
+
+	switch sortBy {
+	case usecase.SortByID:
+		if desc {
+			return item.ID < after.ID
+		}
+		return item.ID > after.ID
+	case usecase.SortByPrice:
+		if item.PurchasePrice != after.Price {
+			if desc {
+				return item.PurchasePrice < after.Price
+			}
+			return item.PurchasePrice > after.Price
+		}
+	default:
+		if item.PurchaseDate != after.PurchaseDate {
+			if desc {
+				return item.PurchaseDate < after.PurchaseDate
+			}
+			return item.PurchaseDate > after.PurchaseDate
+		}
+	}
+	return item.ID > after.ID
+}
+
+func matchesFilter(item *entity.Item, filter usecase.ItemFilter) bool {
+	if filter.Category != "" && item.Category != filter.Category {
+		return false
+	}
+	if filter.BrandPrefix != "" && !strings.HasPrefix(item.Brand, filter.BrandPrefix) {
+		return false
+	}
+	if filter.PurchaseDateFrom != "" && item.PurchaseDate < filter.PurchaseDateFrom {
+		return false
+	}
+	if filter.PurchaseDateTo != "" && item.PurchaseDate > filter.PurchaseDateTo {
+		return false
+	}
+	if filter.PriceMin != nil && item.PurchasePrice < *filter.PriceMin {
+		return false
+	}
+	if filter.PriceMax != nil && item.PurchasePrice > *filter.PriceMax {
+		return false
+	}
+	return true
+}
+
+func matchesListFilter(item *entity.Item, input usecase.ListItemsInput) bool {
+	if input.Category != "" && item.Category != input.Category {
+		return false
+	}
+	if input.BrandContains != "" && !strings.Contains(item.Brand, input.BrandContains) {
+		return false
+	}
+	if input.PriceMin != nil && item.PurchasePrice < *input.PriceMin {
+		return false
+	}
+	if input.PriceMax != nil && item.PurchasePrice > *input.PriceMax {
+		return false
+	}
+	return true
+}
+
+// sortLess returns the "less" comparator for the given sort field/direction,
+// always tie-breaking on ID so the keyset cursor in ListItems is stable.
+func sortLess(sortBy usecase.SortField, sortDir usecase.SortDirection) func(a, b *entity.Item) bool {
+	desc := sortDir == usecase.SortDesc
+
+	key := func(item *entity.Item) (string, int) {
+		switch sortBy {
+		case usecase.SortByPrice:
+			return "", item.PurchasePrice
+		case usecase.SortByID:
+			return "", 0
+		default:
+			return item.PurchaseDate, 0
+		}
+	}
+
+	return func(a, b *entity.Item) bool {
+		if sortBy == usecase.SortByID {
+			if desc {
+				return a.ID > b.ID
+			}
+			return a.ID < b.ID
+		}
+
+		ak, an := key(a)
+		bk, bn := key(b)
+		if ak != bk {
+			if desc {
+				return ak > bk
+			}
+			return ak < bk
+		}
+		if an != bn {
+			if desc {
+				return an > bn
+			}
+			return an < bn
+		}
+		return a.ID < b.ID
+	}
+}
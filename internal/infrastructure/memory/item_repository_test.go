@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+	"Aicon-assignment/internal/usecase"
+)
+
+// TestItemRepository_ListItems_CursorSurvivesDeleteBetweenPages reproduces
+// the scenario where the row a cursor points to is gone by the time the
+// next page is fetched (e.g. it was deleted via DeleteItems/DeleteByFilter
+// between requests): the next page must still resume from the cursor's
+// (sort_key, id) tuple instead of silently restarting from the top.
+func TestItemRepository_ListItems_CursorSurvivesDeleteBetweenPages(t *testing.T) {
+	ctx := context.Background()
+	repo := NewItemRepository()
+
+	for i := 1; i <= 3; i++ {
+		item := &entity.Item{Name: fmt.Sprintf("item-%d", i), Category: "時計", Brand: "ROLEX"}
+		if _, err := repo.Create(ctx, item); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	page1, hasMore, err := repo.ListItems(ctx, usecase.ListItemsInput{Limit: 2, SortBy: usecase.SortByID}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !hasMore || len(page1) != 2 {
+		t.Fatalf("expected a 2-item first page with more to come, got=%v hasMore=%v", page1, hasMore)
+	}
+	last := page1[len(page1)-1]
+
+	// The last item on page 1 is deleted before page 2 is ever requested.
+	if err := repo.Delete(ctx, last.ID); err != nil {
+		t.Fatalf("unexpected error deleting id=%d: %v", last.ID, err)
+	}
+
+	cursor := &usecase.ItemCursor{ID: last.ID}
+	page2, _, err := repo.ListItems(ctx, usecase.ListItemsInput{Limit: 2, SortBy: usecase.SortByID}, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, item := range page2 {
+		for _, seen := range page1 {
+			if item.ID == seen.ID {
+				t.Fatalf("expected page 2 not to replay id=%d already seen on page 1", item.ID)
+			}
+		}
+	}
+}
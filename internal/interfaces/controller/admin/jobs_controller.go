@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"Aicon-assignment/internal/cron"
+
+	"github.com/labstack/echo/v4"
+)
+
+// JobsHandler exposes the status of a cron.Manager's scheduled tasks.
+type JobsHandler struct {
+	manager *cron.Manager
+}
+
+// NewJobsHandler creates a JobsHandler backed by manager.
+func NewJobsHandler(manager *cron.Manager) *JobsHandler {
+	return &JobsHandler{manager: manager}
+}
+
+type jobStatusResponse struct {
+	Name            string    `json:"name"`
+	LastRunAt       time.Time `json:"last_run_at,omitempty"`
+	LastCompletedAt time.Time `json:"last_completed_at,omitempty"`
+	LastError       string    `json:"last_error,omitempty"`
+	Running         bool      `json:"running"`
+}
+
+// GetJobs handles GET /admin/jobs, returning the last-run time and last
+// error for every scheduled task.
+func (h *JobsHandler) GetJobs(c echo.Context) error {
+	statuses := h.manager.Status()
+
+	jobs := make([]jobStatusResponse, 0, len(statuses))
+	for name, status := range statuses {
+		job := jobStatusResponse{
+			Name:            name,
+			LastRunAt:       status.LastRunAt,
+			LastCompletedAt: status.LastCompletedAt,
+			Running:         status.Running,
+		}
+		if status.LastError != nil {
+			job.LastError = status.LastError.Error()
+		}
+		jobs = append(jobs, job)
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{"jobs": jobs})
+}
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"Aicon-assignment/internal/cron"
+
+	"github.com/labstack/echo/v4"
+)
+
+// fakeTicker/fakeClock let the test drive cron.Manager's scheduler
+// deterministically instead of waiting on real time, the same way
+// internal/cron's own tests do.
+type fakeTicker struct {
+	ch chan time.Time
+}
+
+func (f *fakeTicker) C() <-chan time.Time { return f.ch }
+func (f *fakeTicker) Stop()               {}
+
+type fakeClock struct {
+	tickers []*fakeTicker
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) cron.Ticker {
+	t := &fakeTicker{ch: make(chan time.Time, 1)}
+	c.tickers = append(c.tickers, t)
+	return t
+}
+
+// 正常系: タスクが一度も実行されていない場合は running=false、last_error は空
+func TestGetJobs_ReportsRegisteredTaskBeforeItRuns(t *testing.T) {
+	e := echo.New()
+	manager := cron.NewManager(&fakeClock{})
+	if err := manager.AddTask("never-runs", "@every 1h", func(ctx context.Context) error { return nil }); err != nil {
+		t.Fatalf("unexpected error registering task: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/jobs", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := NewJobsHandler(manager)
+	if err := handler.GetJobs(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `"name":"never-runs"`) {
+		t.Errorf("expected job name in response, got=%s", body)
+	}
+	if !strings.Contains(body, `"running":false`) {
+		t.Errorf("expected running=false for a task that hasn't run yet, got=%s", body)
+	}
+	if strings.Contains(body, `"last_error"`) {
+		t.Errorf("expected no last_error for a task that hasn't run yet, got=%s", body)
+	}
+}
+
+// 正常系: タスクが実行されエラーを返した場合は last_error が反映される
+func TestGetJobs_ReportsLastErrorAfterTaskRuns(t *testing.T) {
+	e := echo.New()
+	clock := &fakeClock{}
+	manager := cron.NewManager(clock)
+
+	done := make(chan struct{})
+	if err := manager.AddTask("failing-task", "@every 10ms", func(ctx context.Context) error {
+		defer close(done)
+		return errors.New("boom")
+	}); err != nil {
+		t.Fatalf("unexpected error registering task: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	manager.Start(ctx)
+
+	clock.tickers[0].ch <- time.Now()
+	<-done
+	time.Sleep(5 * time.Millisecond) // let the task finish recording its status
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/jobs", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := NewJobsHandler(manager)
+	if err := handler.GetJobs(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	body := rec.Body.String()
+	if !strings.Contains(body, `"name":"failing-task"`) {
+		t.Errorf("expected job name in response, got=%s", body)
+	}
+	if !strings.Contains(body, `"last_error":"boom"`) {
+		t.Errorf("expected last_error=boom once the task has run, got=%s", body)
+	}
+	if strings.Contains(body, `"running":true`) {
+		t.Errorf("expected the task to no longer be marked running once it completes, got=%s", body)
+	}
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DeleteItems handles DELETE /items?category=...&brand_prefix=...&price_gte=...
+// &price_lte=...&purchase_date_from=...&purchase_date_to=...&allow_all=true,
+// deleting every item matching the filter and reporting how many rows were
+// removed.
+func (h *ItemHandler) DeleteItems(c echo.Context) error {
+	filter, err := parseItemFilterQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+	}
+
+	count, err := h.itemUsecase.DeleteByFilter(c.Request().Context(), filter)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{"deleted": count})
+}
+
+func parseItemFilterQuery(c echo.Context) (usecase.ItemFilter, error) {
+	q := c.QueryParams()
+	filter := usecase.ItemFilter{
+		Category:         q.Get("category"),
+		BrandPrefix:      q.Get("brand_prefix"),
+		PurchaseDateFrom: q.Get("purchase_date_from"),
+		PurchaseDateTo:   q.Get("purchase_date_to"),
+		AllowAll:         q.Get("allow_all") == "true",
+	}
+
+	priceMin, err := parseOptionalIntQueryParam(q, "price_gte")
+	if err != nil {
+		return usecase.ItemFilter{}, err
+	}
+	filter.PriceMin = priceMin
+
+	priceMax, err := parseOptionalIntQueryParam(q, "price_lte")
+	if err != nil {
+		return usecase.ItemFilter{}, err
+	}
+	filter.PriceMax = priceMax
+
+	return filter, nil
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 正常系: フィルタにマッチした件数が返る
+func TestDeleteItems_Success_ReturnsDeletedCount(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodDelete, "/items?category=時計", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUsecase := &mockItemUsecase{
+		DeleteByFilterFunc: func(ctx context.Context, filter usecase.ItemFilter) (int, error) {
+			if filter.Category != "時計" {
+				t.Fatalf("expected category=時計, got=%s", filter.Category)
+			}
+			return 3, nil
+		},
+	}
+	handler := NewItemHandler(mockUsecase)
+
+	if err := handler.DeleteItems(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `"deleted":3`) {
+		t.Errorf("expected body to report deleted=3, got=%s", rec.Body.String())
+	}
+}
+
+// 異常系: 不正なクエリパラメータ（price_gte が数値でない）→ 400
+func TestDeleteItems_BadRequest_InvalidPriceParam(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodDelete, "/items?price_gte=abc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// usecase は呼ばれない想定なので、空のモックでOK
+	mockUsecase := &mockItemUsecase{}
+	handler := NewItemHandler(mockUsecase)
+
+	if err := handler.DeleteItems(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
+
+// 異常系: フィルタが空 → usecase からの ErrInvalidInput を 400 に変換
+func TestDeleteItems_BadRequest_EmptyFilterFromUsecase(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodDelete, "/items", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUsecase := &mockItemUsecase{
+		DeleteByFilterFunc: func(ctx context.Context, filter usecase.ItemFilter) (int, error) {
+			return 0, domainErrors.ErrInvalidInput
+		},
+	}
+	handler := NewItemHandler(mockUsecase)
+
+	if err := handler.DeleteItems(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
+
+// 異常系: usecase で予期しないエラー → 500
+func TestDeleteItems_InternalServerError_FromUsecase(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodDelete, "/items?category=時計", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUsecase := &mockItemUsecase{
+		DeleteByFilterFunc: func(ctx context.Context, filter usecase.ItemFilter) (int, error) {
+			return 0, errors.New("db connection lost")
+		},
+	}
+	handler := NewItemHandler(mockUsecase)
+
+	if err := handler.DeleteItems(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
@@ -20,7 +20,10 @@ import (
 //
 
 type mockItemUsecase struct {
-	UpdateItemFunc func(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error)
+	UpdateItemFunc         func(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error)
+	ListItemsFunc          func(ctx context.Context, input usecase.ListItemsInput) (*usecase.ItemPage, error)
+	DeleteByFilterFunc     func(ctx context.Context, filter usecase.ItemFilter) (int, error)
+	GetCategorySummaryFunc func(ctx context.Context) (*usecase.CategorySummary, error)
 }
 
 func (m *mockItemUsecase) GetAllItems(ctx context.Context) ([]*entity.Item, error) {
@@ -40,6 +43,9 @@ func (m *mockItemUsecase) DeleteItem(ctx context.Context, id int64) error {
 }
 
 func (m *mockItemUsecase) GetCategorySummary(ctx context.Context) (*usecase.CategorySummary, error) {
+	if m.GetCategorySummaryFunc != nil {
+		return m.GetCategorySummaryFunc(ctx)
+	}
 	return &usecase.CategorySummary{}, nil
 }
 
@@ -50,6 +56,26 @@ func (m *mockItemUsecase) UpdateItem(ctx context.Context, id int64, input usecas
 	return nil, nil
 }
 
+func (m *mockItemUsecase) DeleteItems(ctx context.Context, ids []int64) ([]usecase.ItemDeleteResult, error) {
+	return nil, nil
+}
+
+func (m *mockItemUsecase) DeleteByFilter(ctx context.Context, filter usecase.ItemFilter) (int, error) {
+	if m.DeleteByFilterFunc != nil {
+		return m.DeleteByFilterFunc(ctx, filter)
+	}
+	return 0, nil
+}
+
+func (m *mockItemUsecase) ListItems(ctx context.Context, input usecase.ListItemsInput) (*usecase.ItemPage, error) {
+	if m.ListItemsFunc != nil {
+		return m.ListItemsFunc(ctx, input)
+	}
+	return &usecase.ItemPage{}, nil
+}
+
+func (m *mockItemUsecase) Use(hook interface{}) {}
+
 //
 // テスト本体
 //
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// defaultListLimit is applied when the limit query param is omitted
+// entirely; an explicit limit (including 0) is passed through as-is so
+// usecase.ListItems can reject an out-of-range value.
+const defaultListLimit = usecase.DefaultListLimit
+
+// ListItems handles GET /items?limit=...&cursor=...&category=...
+// &brand_contains=...&price_gte=...&price_lte=...&sort_by=...&sort_dir=...,
+// returning a keyset-paginated, filtered, and sorted page of items.
+func (h *ItemHandler) ListItems(c echo.Context) error {
+	input, err := parseListItemsQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+	}
+
+	page, err := h.itemUsecase.ListItems(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
+
+func parseListItemsQuery(c echo.Context) (usecase.ListItemsInput, error) {
+	q := c.QueryParams()
+	input := usecase.ListItemsInput{
+		Cursor:        q.Get("cursor"),
+		Category:      q.Get("category"),
+		BrandContains: q.Get("brand_contains"),
+		Limit:         defaultListLimit,
+		SortBy:        usecase.SortField(q.Get("sort_by")),
+		SortDir:       usecase.SortDirection(q.Get("sort_dir")),
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return usecase.ListItemsInput{}, fmt.Errorf("limit must be an integer")
+		}
+		input.Limit = limit
+	}
+
+	priceMin, err := parseOptionalIntQueryParam(q, "price_gte")
+	if err != nil {
+		return usecase.ListItemsInput{}, err
+	}
+	input.PriceMin = priceMin
+
+	priceMax, err := parseOptionalIntQueryParam(q, "price_lte")
+	if err != nil {
+		return usecase.ListItemsInput{}, err
+	}
+	input.PriceMax = priceMax
+
+	return input, nil
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 正常系: cursor をそのまま usecase に渡し、返ってきた NextCursor がレスポンスに乗る
+func TestListItems_CursorRoundTrip(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/items?cursor=abc123&limit=10", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUsecase := &mockItemUsecase{
+		ListItemsFunc: func(ctx context.Context, input usecase.ListItemsInput) (*usecase.ItemPage, error) {
+			if input.Cursor != "abc123" {
+				t.Fatalf("expected cursor=abc123, got=%s", input.Cursor)
+			}
+			if input.Limit != 10 {
+				t.Fatalf("expected limit=10, got=%d", input.Limit)
+			}
+			return &usecase.ItemPage{NextCursor: "def456", HasMore: true}, nil
+		},
+	}
+
+	handler := NewItemHandler(mockUsecase)
+	if err := handler.ListItems(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+
+	var resp usecase.ItemPage
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.NextCursor != "def456" {
+		t.Errorf("expected next_cursor=def456, got=%s", resp.NextCursor)
+	}
+	if !resp.HasMore {
+		t.Error("expected has_more=true")
+	}
+}
+
+// 正常系: フィルタとソートを組み合わせて usecase に渡せる
+func TestListItems_CombinedFilterAndSort(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/items?category=%E6%99%82%E8%A8%88&brand_contains=ROLEX&price_gte=1000&price_lte=5000&sort_by=price&sort_dir=desc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var gotInput usecase.ListItemsInput
+	mockUsecase := &mockItemUsecase{
+		ListItemsFunc: func(ctx context.Context, input usecase.ListItemsInput) (*usecase.ItemPage, error) {
+			gotInput = input
+			return &usecase.ItemPage{}, nil
+		},
+	}
+
+	handler := NewItemHandler(mockUsecase)
+	if err := handler.ListItems(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if gotInput.Category != "時計" {
+		t.Errorf("expected category=時計, got=%s", gotInput.Category)
+	}
+	if gotInput.BrandContains != "ROLEX" {
+		t.Errorf("expected brand_contains=ROLEX, got=%s", gotInput.BrandContains)
+	}
+	if gotInput.PriceMin == nil || *gotInput.PriceMin != 1000 {
+		t.Errorf("expected price_gte=1000, got=%v", gotInput.PriceMin)
+	}
+	if gotInput.PriceMax == nil || *gotInput.PriceMax != 5000 {
+		t.Errorf("expected price_lte=5000, got=%v", gotInput.PriceMax)
+	}
+	if gotInput.SortBy != usecase.SortByPrice {
+		t.Errorf("expected sort_by=price, got=%s", gotInput.SortBy)
+	}
+	if gotInput.SortDir != usecase.SortDesc {
+		t.Errorf("expected sort_dir=desc, got=%s", gotInput.SortDir)
+	}
+}
+
+// 異常系: usecase 側がカーソル不正などでエラーを返した場合は 400
+func TestListItems_InvalidInputFromUsecase_BadRequest(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/items?cursor=not-valid", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUsecase := &mockItemUsecase{
+		ListItemsFunc: func(ctx context.Context, input usecase.ListItemsInput) (*usecase.ItemPage, error) {
+			return nil, fmt.Errorf("%w: malformed cursor", domainErrors.ErrInvalidInput)
+		},
+	}
+
+	handler := NewItemHandler(mockUsecase)
+	if err := handler.ListItems(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
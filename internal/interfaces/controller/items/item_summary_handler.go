@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"net/http"
+
+	"Aicon-assignment/internal/cron"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CategorySummaryHandler serves GET /items/summary from cron.SummaryCache
+// instead of recomputing GetCategorySummary on every request; the cache is
+// kept warm in the background by cron.NewCategorySummaryCacheJob.
+type CategorySummaryHandler struct {
+	cache *cron.SummaryCache
+}
+
+// NewCategorySummaryHandler creates a CategorySummaryHandler backed by cache.
+func NewCategorySummaryHandler(cache *cron.SummaryCache) *CategorySummaryHandler {
+	return &CategorySummaryHandler{cache: cache}
+}
+
+// GetCategorySummary handles GET /items/summary, returning the most
+// recently cached category summary. It responds 503 until the cache job
+// has populated the cache at least once.
+func (h *CategorySummaryHandler) GetCategorySummary(c echo.Context) error {
+	summary, asOf, ok := h.cache.Get()
+	if !ok {
+		return c.JSON(http.StatusServiceUnavailable, echo.Map{"error": "category summary not yet available"})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"categories": summary.Categories,
+		"total":      summary.Total,
+		"as_of":      asOf,
+	})
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"Aicon-assignment/internal/cron"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 異常系: キャッシュジョブがまだ一度も実行されていない → 503
+func TestGetCategorySummary_ServiceUnavailable_BeforeCacheIsWarm(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/items/summary", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := NewCategorySummaryHandler(&cron.SummaryCache{})
+
+	if err := handler.GetCategorySummary(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %d, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+}
+
+// 正常系: キャッシュジョブの実行後はキャッシュされた内容が返る
+func TestGetCategorySummary_ReturnsCachedSummaryOnceWarm(t *testing.T) {
+	e := echo.New()
+
+	cache := &cron.SummaryCache{}
+	mockUsecase := &mockItemUsecase{
+		GetCategorySummaryFunc: func(ctx context.Context) (*usecase.CategorySummary, error) {
+			return &usecase.CategorySummary{Categories: map[string]int{"時計": 2}, Total: 2}, nil
+		},
+	}
+	if err := cron.NewCategorySummaryCacheJob(mockUsecase, cache)(context.Background()); err != nil {
+		t.Fatalf("unexpected error warming the cache: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/items/summary", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := NewCategorySummaryHandler(cache)
+
+	if err := handler.GetCategorySummary(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `"total":2`) {
+		t.Errorf("expected cached total=2 in response, got=%s", body)
+	}
+	if !strings.Contains(body, `"時計":2`) {
+		t.Errorf("expected cached category counts in response, got=%s", body)
+	}
+}
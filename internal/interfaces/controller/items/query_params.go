@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// parseOptionalIntQueryParam parses name from q as an int, returning nil
+// if the param is absent. Shared by the list and bulk-delete handlers,
+// which both accept price_gte/price_lte filters.
+func parseOptionalIntQueryParam(q url.Values, name string) (*int, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an integer", name)
+	}
+	return &n, nil
+}
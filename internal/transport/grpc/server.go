@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/transport/grpc/pb"
+	"Aicon-assignment/internal/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ItemServer implements pb.ItemServiceServer on top of usecase.ItemUsecase,
+// mirroring the Echo handlers in internal/interfaces/controller/items so
+// both transports share one source of truth for business logic.
+type ItemServer struct {
+	pb.UnimplementedItemServiceServer
+	itemUsecase usecase.ItemUsecase
+}
+
+// NewItemServer creates an ItemServer backed by itemUsecase.
+func NewItemServer(itemUsecase usecase.ItemUsecase) *ItemServer {
+	return &ItemServer{itemUsecase: itemUsecase}
+}
+
+func (s *ItemServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.Item, error) {
+	item, err := s.itemUsecase.GetItemByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoItem(item), nil
+}
+
+func (s *ItemServer) CreateItem(ctx context.Context, req *pb.CreateItemRequest) (*pb.Item, error) {
+	item, err := s.itemUsecase.CreateItem(ctx, usecase.CreateItemInput{
+		Name:          req.GetName(),
+		Category:      req.GetCategory(),
+		Brand:         req.GetBrand(),
+		PurchasePrice: int(req.GetPurchasePrice()),
+		PurchaseDate:  req.GetPurchaseDate(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoItem(item), nil
+}
+
+func (s *ItemServer) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*pb.Item, error) {
+	input, err := updateInputFromFieldMask(req.GetItem(), req.GetUpdateMask())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	item, err := s.itemUsecase.UpdateItem(ctx, req.GetId(), input)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoItem(item), nil
+}
+
+func (s *ItemServer) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest) (*pb.DeleteItemResponse, error) {
+	if err := s.itemUsecase.DeleteItem(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteItemResponse{}, nil
+}
+
+func (s *ItemServer) ListItems(req *pb.ListItemsRequest, stream pb.ItemService_ListItemsServer) error {
+	items, err := s.itemUsecase.GetAllItems(stream.Context())
+	if err != nil {
+		return toStatus(err)
+	}
+
+	for _, item := range items {
+		if err := stream.Send(toProtoItem(item)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ItemServer) GetCategorySummary(ctx context.Context, req *pb.GetCategorySummaryRequest) (*pb.GetCategorySummaryResponse, error) {
+	summary, err := s.itemUsecase.GetCategorySummary(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	categories := make(map[string]int32, len(summary.Categories))
+	for category, count := range summary.Categories {
+		categories[category] = int32(count)
+	}
+
+	return &pb.GetCategorySummaryResponse{
+		Categories: categories,
+		Total:      int32(summary.Total),
+	}, nil
+}
+
+// toStatus maps domain errors onto the same classification the Echo
+// handlers use for HTTP status codes.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, domainErrors.ErrItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domainErrors.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoItem(item *entity.Item) *pb.Item {
+	return &pb.Item{
+		Id:            item.ID,
+		Name:          item.Name,
+		Category:      item.Category,
+		Brand:         item.Brand,
+		PurchasePrice: int32(item.PurchasePrice),
+		PurchaseDate:  item.PurchaseDate,
+	}
+}
+
+// updateInputFromFieldMask maps the fields named in mask from item onto
+// usecase.UpdateItemInput's pointer fields, the same partial-update shape
+// the Echo PATCH handler builds from a JSON body.
+func updateInputFromFieldMask(item *pb.Item, mask *fieldmaskpb.FieldMask) (usecase.UpdateItemInput, error) {
+	var input usecase.UpdateItemInput
+	if item == nil || mask == nil || len(mask.GetPaths()) == 0 {
+		return input, errors.New("update_mask must name at least one field")
+	}
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "name":
+			name := item.GetName()
+			input.Name = &name
+		case "brand":
+			brand := item.GetBrand()
+			input.Brand = &brand
+		case "purchase_price":
+			price := int(item.GetPurchasePrice())
+			input.PurchasePrice = &price
+		default:
+			return usecase.UpdateItemInput{}, fmt.Errorf("unsupported update_mask path %q", path)
+		}
+	}
+
+	return input, nil
+}
@@ -0,0 +1,116 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	grpctransport "Aicon-assignment/internal/transport/grpc"
+	"Aicon-assignment/internal/transport/grpc/pb"
+	"Aicon-assignment/internal/usecase"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const bufSize = 1024 * 1024
+
+// stubItemUsecase embeds usecase.ItemUsecase so tests only need to
+// override the method under test.
+type stubItemUsecase struct {
+	usecase.ItemUsecase
+	updateItemFunc func(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error)
+}
+
+func (s *stubItemUsecase) UpdateItem(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error) {
+	return s.updateItemFunc(ctx, id, input)
+}
+
+func dial(t *testing.T, uc usecase.ItemUsecase) (pb.ItemServiceClient, func()) {
+	t.Helper()
+
+	lis := bufconn.Listen(bufSize)
+	srv := grpc.NewServer()
+	pb.RegisterItemServiceServer(srv, grpctransport.NewItemServer(uc))
+	go func() { _ = srv.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufconn: %v", err)
+	}
+
+	return pb.NewItemServiceClient(conn), func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+// 正常系: name だけ更新できる
+func TestItemServer_UpdateItem_NameOnly(t *testing.T) {
+	const wantName = "更新後の名前"
+	uc := &stubItemUsecase{
+		updateItemFunc: func(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error) {
+			if id != 1 || input.Name == nil || *input.Name != wantName {
+				t.Fatalf("unexpected input: id=%d input=%+v", id, input)
+			}
+			return &entity.Item{ID: 1, Name: wantName}, nil
+		},
+	}
+	client, closeFn := dial(t, uc)
+	defer closeFn()
+
+	resp, err := client.UpdateItem(context.Background(), &pb.UpdateItemRequest{
+		Id:         1,
+		Item:       &pb.Item{Name: wantName},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateItem returned error: %v", err)
+	}
+	if resp.GetName() != wantName {
+		t.Fatalf("expected name=%s, got=%s", wantName, resp.GetName())
+	}
+}
+
+// 異常系: update_mask が空 → InvalidArgument
+func TestItemServer_UpdateItem_NoFields_InvalidArgument(t *testing.T) {
+	uc := &stubItemUsecase{}
+	client, closeFn := dial(t, uc)
+	defer closeFn()
+
+	_, err := client.UpdateItem(context.Background(), &pb.UpdateItemRequest{Id: 1, Item: &pb.Item{}})
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got=%v", err)
+	}
+}
+
+// 異常系: 対象IDが存在しない → NotFound
+func TestItemServer_UpdateItem_MissingID_NotFound(t *testing.T) {
+	uc := &stubItemUsecase{
+		updateItemFunc: func(ctx context.Context, id int64, input usecase.UpdateItemInput) (*entity.Item, error) {
+			return nil, domainErrors.ErrItemNotFound
+		},
+	}
+	client, closeFn := dial(t, uc)
+	defer closeFn()
+
+	_, err := client.UpdateItem(context.Background(), &pb.UpdateItemRequest{
+		Id:         999,
+		Item:       &pb.Item{Name: "hoge"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got=%v", err)
+	}
+}
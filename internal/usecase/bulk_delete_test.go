@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_DeleteByFilter_RejectsEmptyFilter(t *testing.T) {
+	repo := &fakeItemRepository{items: map[int64]*entity.Item{}}
+	uc := NewItemUsecase(repo)
+
+	_, err := uc.DeleteByFilter(context.Background(), ItemFilter{})
+
+	if !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for an empty filter, got=%v", err)
+	}
+}
+
+func TestItemUsecase_DeleteByFilter_NoMatchReturnsZero(t *testing.T) {
+	repo := &fakeItemRepository{
+		items: map[int64]*entity.Item{
+			1: {ID: 1, Category: "時計"},
+		},
+	}
+	uc := NewItemUsecase(repo)
+
+	count, err := uc.DeleteByFilter(context.Background(), ItemFilter{Category: "バッグ"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("expected count=0, got=%d", count)
+	}
+}
+
+func TestItemUsecase_DeleteItems_PartialFailureSurfacesPerIDErrors(t *testing.T) {
+	// The repo reports each id's own outcome, unformatted; the usecase is
+	// what's responsible for wrapping the failing one as "id %d: ...".
+	repo := &fakeItemRepository{
+		items: map[int64]*entity.Item{1: {ID: 1}, 2: {ID: 2}},
+		deleteItemsFunc: func(ctx context.Context, ids []int64) ([]ItemDeleteResult, error) {
+			results := make([]ItemDeleteResult, 0, len(ids))
+			for _, id := range ids {
+				if id == 2 {
+					results = append(results, ItemDeleteResult{ID: id, Error: errors.New("row locked")})
+					continue
+				}
+				results = append(results, ItemDeleteResult{ID: id})
+			}
+			return results, nil
+		},
+	}
+	uc := NewItemUsecase(repo)
+
+	results, err := uc.DeleteItems(context.Background(), []int64{1, 2})
+
+	if len(results) != 2 {
+		t.Fatalf("expected a result for every id, got=%v", results)
+	}
+	if results[0].ID != 1 || results[0].Error != nil {
+		t.Fatalf("expected id=1 to succeed, got=%+v", results[0])
+	}
+	if results[1].ID != 2 || results[1].Error == nil {
+		t.Fatalf("expected id=2 to fail, got=%+v", results[1])
+	}
+	if err == nil || !strings.Contains(err.Error(), "id 2") {
+		t.Fatalf("expected error to mention the failing id, got=%v", err)
+	}
+}
+
+func TestItemUsecase_DeleteItems_CancellationAbortsRemaining(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var repoCalled bool
+	repo := &fakeItemRepository{
+		items: map[int64]*entity.Item{1: {ID: 1}},
+		deleteItemsFunc: func(ctx context.Context, ids []int64) ([]ItemDeleteResult, error) {
+			repoCalled = true
+			return nil, nil
+		},
+	}
+	uc := NewItemUsecase(repo)
+
+	results, err := uc.DeleteItems(ctx, []int64{1})
+
+	if err == nil {
+		t.Fatal("expected cancellation to surface as an error")
+	}
+	if repoCalled {
+		t.Fatal("expected the repository not to be called once the context is already cancelled")
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no results, got=%v", results)
+	}
+}
+
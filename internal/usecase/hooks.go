@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// BeforeCreateHandler is invoked before an item is persisted by CreateItem.
+// Returning an error aborts the operation before the repository is called.
+type BeforeCreateHandler interface {
+	BeforeCreate(ctx context.Context, item *entity.Item) error
+}
+
+// AfterCreateHandler is invoked once CreateItem has run to completion.
+// Implementations may observe or mutate the returned item and error.
+type AfterCreateHandler interface {
+	AfterCreate(ctx context.Context, item *entity.Item, err *error)
+}
+
+// BeforeUpdateHandler is invoked before the existing item is persisted by
+// UpdateItem. Returning an error aborts the operation before the repository
+// is called.
+type BeforeUpdateHandler interface {
+	BeforeUpdate(ctx context.Context, item *entity.Item) error
+}
+
+// AfterUpdateHandler is invoked once UpdateItem has run to completion.
+type AfterUpdateHandler interface {
+	AfterUpdate(ctx context.Context, item *entity.Item, err *error)
+}
+
+// BeforeDeleteHandler is invoked before DeleteItem calls the repository.
+// Returning an error aborts the operation before the repository is called.
+type BeforeDeleteHandler interface {
+	BeforeDelete(ctx context.Context, item *entity.Item) error
+}
+
+// AfterDeleteHandler is invoked once DeleteItem has run to completion, even
+// when the repository call itself failed.
+type AfterDeleteHandler interface {
+	AfterDelete(ctx context.Context, item *entity.Item, err *error)
+}
+
+// hookRegistry holds the hooks attached to an itemUsecase via Use. Hooks run
+// in registration order within each slice.
+type hookRegistry struct {
+	beforeCreate []BeforeCreateHandler
+	afterCreate  []AfterCreateHandler
+	beforeUpdate []BeforeUpdateHandler
+	afterUpdate  []AfterUpdateHandler
+	beforeDelete []BeforeDeleteHandler
+	afterDelete  []AfterDeleteHandler
+}
+
+// Use registers hook against every *Handler interface it implements. A
+// single value may implement more than one of them, e.g. an audit logger
+// that hooks both creates and deletes.
+func (u *itemUsecase) Use(hook interface{}) {
+	if h, ok := hook.(BeforeCreateHandler); ok {
+		u.hooks.beforeCreate = append(u.hooks.beforeCreate, h)
+	}
+	if h, ok := hook.(AfterCreateHandler); ok {
+		u.hooks.afterCreate = append(u.hooks.afterCreate, h)
+	}
+	if h, ok := hook.(BeforeUpdateHandler); ok {
+		u.hooks.beforeUpdate = append(u.hooks.beforeUpdate, h)
+	}
+	if h, ok := hook.(AfterUpdateHandler); ok {
+		u.hooks.afterUpdate = append(u.hooks.afterUpdate, h)
+	}
+	if h, ok := hook.(BeforeDeleteHandler); ok {
+		u.hooks.beforeDelete = append(u.hooks.beforeDelete, h)
+	}
+	if h, ok := hook.(AfterDeleteHandler); ok {
+		u.hooks.afterDelete = append(u.hooks.afterDelete, h)
+	}
+}
+
+func (u *itemUsecase) runBeforeCreate(ctx context.Context, item *entity.Item) error {
+	for _, h := range u.hooks.beforeCreate {
+		if err := h.BeforeCreate(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *itemUsecase) runAfterCreate(ctx context.Context, item *entity.Item, err *error) {
+	for _, h := range u.hooks.afterCreate {
+		h.AfterCreate(ctx, item, err)
+	}
+}
+
+func (u *itemUsecase) runBeforeUpdate(ctx context.Context, item *entity.Item) error {
+	for _, h := range u.hooks.beforeUpdate {
+		if err := h.BeforeUpdate(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *itemUsecase) runAfterUpdate(ctx context.Context, item *entity.Item, err *error) {
+	for _, h := range u.hooks.afterUpdate {
+		h.AfterUpdate(ctx, item, err)
+	}
+}
+
+func (u *itemUsecase) runBeforeDelete(ctx context.Context, item *entity.Item) error {
+	for _, h := range u.hooks.beforeDelete {
+		if err := h.BeforeDelete(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *itemUsecase) runAfterDelete(ctx context.Context, item *entity.Item, err *error) {
+	for _, h := range u.hooks.afterDelete {
+		h.AfterDelete(ctx, item, err)
+	}
+}
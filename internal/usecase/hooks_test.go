@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// fakeItemRepository is a minimal in-memory ItemRepository stand-in used to
+// exercise the hook chain without a real datastore.
+type fakeItemRepository struct {
+	items map[int64]*entity.Item
+
+	createFunc         func(ctx context.Context, item *entity.Item) (*entity.Item, error)
+	deleteFunc         func(ctx context.Context, id int64) error
+	deleteItemsFunc    func(ctx context.Context, ids []int64) ([]ItemDeleteResult, error)
+	deleteByFilterFunc func(ctx context.Context, filter ItemFilter) (int, error)
+	listItemsFunc      func(ctx context.Context, input ListItemsInput, after *ItemCursor) ([]*entity.Item, bool, error)
+}
+
+func (r *fakeItemRepository) FindAll(ctx context.Context) ([]*entity.Item, error) {
+	items := make([]*entity.Item, 0, len(r.items))
+	for _, item := range r.items {
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (r *fakeItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	item, ok := r.items[id]
+	if !ok {
+		return nil, domainErrors.ErrItemNotFound
+	}
+	return item, nil
+}
+
+func (r *fakeItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	if r.createFunc != nil {
+		return r.createFunc(ctx, item)
+	}
+	r.items[item.ID] = item
+	return item, nil
+}
+
+func (r *fakeItemRepository) Delete(ctx context.Context, id int64) error {
+	if r.deleteFunc != nil {
+		return r.deleteFunc(ctx, id)
+	}
+	delete(r.items, id)
+	return nil
+}
+
+func (r *fakeItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	r.items[item.ID] = item
+	return nil
+}
+
+func (r *fakeItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	return nil, nil
+}
+
+func (r *fakeItemRepository) DeleteItems(ctx context.Context, ids []int64) ([]ItemDeleteResult, error) {
+	if r.deleteItemsFunc != nil {
+		return r.deleteItemsFunc(ctx, ids)
+	}
+	results := make([]ItemDeleteResult, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := r.items[id]; !ok {
+			results = append(results, ItemDeleteResult{ID: id, Error: domainErrors.ErrItemNotFound})
+			continue
+		}
+		delete(r.items, id)
+		results = append(results, ItemDeleteResult{ID: id})
+	}
+	return results, nil
+}
+
+func (r *fakeItemRepository) ListItems(ctx context.Context, input ListItemsInput, after *ItemCursor) ([]*entity.Item, bool, error) {
+	if r.listItemsFunc != nil {
+		return r.listItemsFunc(ctx, input, after)
+	}
+	return nil, false, nil
+}
+
+func (r *fakeItemRepository) DeleteByFilter(ctx context.Context, filter ItemFilter) (int, error) {
+	if r.deleteByFilterFunc != nil {
+		return r.deleteByFilterFunc(ctx, filter)
+	}
+	count := 0
+	for id, item := range r.items {
+		if filter.Category != "" && item.Category != filter.Category {
+			continue
+		}
+		delete(r.items, id)
+		count++
+	}
+	return count, nil
+}
+
+// abortingBeforeCreateHook always rejects the create, proving the
+// repository is never reached.
+type abortingBeforeCreateHook struct {
+	called bool
+	err    error
+}
+
+func (h *abortingBeforeCreateHook) BeforeCreate(ctx context.Context, item *entity.Item) error {
+	h.called = true
+	return h.err
+}
+
+// observingAfterDeleteHook records whatever DeleteItem ultimately returned,
+// including a repository failure.
+type observingAfterDeleteHook struct {
+	called  bool
+	gotItem *entity.Item
+	gotErr  error
+}
+
+func (h *observingAfterDeleteHook) AfterDelete(ctx context.Context, item *entity.Item, err *error) {
+	h.called = true
+	h.gotItem = item
+	h.gotErr = *err
+}
+
+func TestItemUsecase_BeforeCreateHook_AbortsWithoutCallingRepository(t *testing.T) {
+	hookErr := fmt.Errorf("%w: name contains a banned word", domainErrors.ErrInvalidInput)
+	repo := &fakeItemRepository{
+		items: map[int64]*entity.Item{},
+		createFunc: func(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+			t.Fatal("repository Create should not be called when a pre-hook aborts")
+			return nil, nil
+		},
+	}
+
+	uc := NewItemUsecase(repo)
+	hook := &abortingBeforeCreateHook{err: hookErr}
+	uc.Use(hook)
+
+	_, err := uc.CreateItem(context.Background(), CreateItemInput{
+		Name:          "時計A",
+		Category:      "時計",
+		Brand:         "ROLEX",
+		PurchasePrice: 1000,
+		PurchaseDate:  "2023-01-01",
+	})
+
+	if !hook.called {
+		t.Fatal("expected BeforeCreate to be called")
+	}
+	if !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected error to classify as ErrInvalidInput, got=%v", err)
+	}
+}
+
+func TestItemUsecase_AfterDeleteHook_FiresEvenWhenRepositoryFails(t *testing.T) {
+	existing := &entity.Item{ID: 1, Name: "時計A", Category: "時計", Brand: "ROLEX"}
+	repo := &fakeItemRepository{
+		items: map[int64]*entity.Item{1: existing},
+		deleteFunc: func(ctx context.Context, id int64) error {
+			return errors.New("db connection lost")
+		},
+	}
+
+	uc := NewItemUsecase(repo)
+	hook := &observingAfterDeleteHook{}
+	uc.Use(hook)
+
+	err := uc.DeleteItem(context.Background(), 1)
+
+	if !hook.called {
+		t.Fatal("expected AfterDelete to be called even though the repository failed")
+	}
+	if err == nil {
+		t.Fatal("expected DeleteItem to return the repository error")
+	}
+	if hook.gotErr == nil {
+		t.Fatal("expected AfterDelete to observe the repository error")
+	}
+	if hook.gotItem == nil || hook.gotItem.ID != 1 {
+		t.Fatalf("expected AfterDelete to observe the deleted item, got=%v", hook.gotItem)
+	}
+}
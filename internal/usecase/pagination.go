@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// SortField selects which column ListItems orders by.
+type SortField string
+
+const (
+	SortByPurchaseDate SortField = "purchase_date"
+	SortByPrice        SortField = "price"
+	SortByID           SortField = "id"
+)
+
+// SortDirection selects ascending or descending order for ListItems.
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+const (
+	// DefaultListLimit is the page size callers should apply when the
+	// limit query param is omitted entirely. Controllers are responsible
+	// for this default; ListItems itself rejects a literal 0 like any
+	// other out-of-range value.
+	DefaultListLimit = 20
+	minListLimit     = 1
+	maxListLimit     = 100
+)
+
+// ListItemsInput narrows, sorts, and paginates a ListItems call.
+type ListItemsInput struct {
+	Limit         int
+	Cursor        string
+	Category      string
+	BrandContains string
+	PriceMin      *int
+	PriceMax      *int
+	SortBy        SortField
+	SortDir       SortDirection
+}
+
+// ItemPage is one page of a keyset-paginated item listing.
+type ItemPage struct {
+	Items      []*entity.Item `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	HasMore    bool           `json:"has_more"`
+}
+
+// ItemCursor is the decoded form of ListItemsInput.Cursor / ItemPage.NextCursor:
+// the (sort_key, id) tuple the repository resumes a keyset query from. Only
+// the field matching the active SortBy is populated alongside ID.
+type ItemCursor struct {
+	ID           int64  `json:"id"`
+	PurchaseDate string `json:"purchase_date,omitempty"`
+	Price        int    `json:"price,omitempty"`
+}
+
+func encodeItemCursor(c *ItemCursor) (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(raw), nil
+}
+
+func decodeItemCursor(s string) (*ItemCursor, error) {
+	raw, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var c ItemCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (u *itemUsecase) ListItems(ctx context.Context, input ListItemsInput) (*ItemPage, error) {
+	// 0 and negative sizes are rejected outright; callers that want the
+	// default must omit limit rather than pass 0 (mirrors the
+	// newPaginatorFromPages rejection pattern).
+	if input.Limit < minListLimit || input.Limit > maxListLimit {
+		return nil, fmt.Errorf("%w: limit must be between %d and %d", domainErrors.ErrInvalidInput, minListLimit, maxListLimit)
+	}
+
+	var cursor *ItemCursor
+	if input.Cursor != "" {
+		decoded, err := decodeItemCursor(input.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed cursor", domainErrors.ErrInvalidInput)
+		}
+		cursor = decoded
+	}
+
+	switch input.SortBy {
+	case "", SortByPurchaseDate, SortByPrice, SortByID:
+	default:
+		return nil, fmt.Errorf("%w: unsupported sort_by %q", domainErrors.ErrInvalidInput, input.SortBy)
+	}
+
+	switch input.SortDir {
+	case "", SortAsc, SortDesc:
+	default:
+		return nil, fmt.Errorf("%w: unsupported sort_dir %q", domainErrors.ErrInvalidInput, input.SortDir)
+	}
+
+	items, hasMore, err := u.itemRepo.ListItems(ctx, input, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+
+	page := &ItemPage{Items: items, HasMore: hasMore}
+	if hasMore && len(items) > 0 {
+		last := items[len(items)-1]
+		nextCursor, err := encodeItemCursor(nextCursorFor(input.SortBy, last))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode next cursor: %w", err)
+		}
+		page.NextCursor = nextCursor
+	}
+
+	return page, nil
+}
+
+// nextCursorFor builds the resume-point cursor for the given sort field.
+// ID is always included as the tiebreaker; the sort field's own value is
+// included alongside it so the repository's keyset WHERE clause has both
+// halves of (sort_key, id) to compare against.
+func nextCursorFor(sortBy SortField, last *entity.Item) *ItemCursor {
+	cursor := &ItemCursor{ID: last.ID}
+	switch sortBy {
+	case SortByPrice:
+		cursor.Price = last.PurchasePrice
+	case SortByID:
+		// ID is the sort key itself; nothing else to carry.
+	default:
+		cursor.PurchaseDate = last.PurchaseDate
+	}
+	return cursor
+}
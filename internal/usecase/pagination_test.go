@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_ListItems_RejectsOutOfRangeLimit(t *testing.T) {
+	repo := &fakeItemRepository{items: map[int64]*entity.Item{}}
+	uc := NewItemUsecase(repo)
+
+	// 0 is rejected too: applying the DefaultListLimit for an omitted
+	// limit is the controller's job, not ListItems'.
+	for _, limit := range []int{-1, 0, 101} {
+		_, err := uc.ListItems(context.Background(), ListItemsInput{Limit: limit})
+		if !errors.Is(err, domainErrors.ErrInvalidInput) {
+			t.Fatalf("limit=%d: expected ErrInvalidInput, got=%v", limit, err)
+		}
+	}
+}
+
+func TestItemUsecase_ListItems_RejectsMalformedCursor(t *testing.T) {
+	repo := &fakeItemRepository{items: map[int64]*entity.Item{}}
+	uc := NewItemUsecase(repo)
+
+	_, err := uc.ListItems(context.Background(), ListItemsInput{Limit: DefaultListLimit, Cursor: "not-valid-base64!!"})
+
+	if !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for a malformed cursor, got=%v", err)
+	}
+}
+
+func TestItemUsecase_ListItems_RejectsUnsupportedSortDir(t *testing.T) {
+	repo := &fakeItemRepository{items: map[int64]*entity.Item{}}
+	uc := NewItemUsecase(repo)
+
+	_, err := uc.ListItems(context.Background(), ListItemsInput{Limit: DefaultListLimit, SortDir: "ascending"})
+
+	if !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for an unsupported sort_dir, got=%v", err)
+	}
+}
+
+func TestItemUsecase_ListItems_CursorRoundTrip(t *testing.T) {
+	last := &entity.Item{ID: 5, PurchaseDate: "2023-05-01"}
+	repo := &fakeItemRepository{
+		items: map[int64]*entity.Item{},
+		listItemsFunc: func(ctx context.Context, input ListItemsInput, after *ItemCursor) ([]*entity.Item, bool, error) {
+			return []*entity.Item{last}, true, nil
+		},
+	}
+	uc := NewItemUsecase(repo)
+
+	page, err := uc.ListItems(context.Background(), ListItemsInput{Limit: DefaultListLimit})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.NextCursor == "" {
+		t.Fatal("expected NextCursor to be set when HasMore is true")
+	}
+
+	var gotCursor *ItemCursor
+	repo.listItemsFunc = func(ctx context.Context, input ListItemsInput, after *ItemCursor) ([]*entity.Item, bool, error) {
+		gotCursor = after
+		return nil, false, nil
+	}
+
+	if _, err := uc.ListItems(context.Background(), ListItemsInput{Limit: DefaultListLimit, Cursor: page.NextCursor}); err != nil {
+		t.Fatalf("unexpected error decoding round-tripped cursor: %v", err)
+	}
+	if gotCursor == nil || gotCursor.ID != last.ID || gotCursor.PurchaseDate != last.PurchaseDate {
+		t.Fatalf("expected decoded cursor to match the encoded one, got=%+v", gotCursor)
+	}
+}
+
+func TestItemUsecase_ListItems_CursorCarriesActiveSortKey(t *testing.T) {
+	last := &entity.Item{ID: 7, PurchaseDate: "2023-05-01", PurchasePrice: 4200}
+	repo := &fakeItemRepository{
+		items: map[int64]*entity.Item{},
+		listItemsFunc: func(ctx context.Context, input ListItemsInput, after *ItemCursor) ([]*entity.Item, bool, error) {
+			return []*entity.Item{last}, true, nil
+		},
+	}
+	uc := NewItemUsecase(repo)
+
+	page, err := uc.ListItems(context.Background(), ListItemsInput{Limit: DefaultListLimit, SortBy: SortByPrice})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decoded, err := decodeItemCursor(page.NextCursor)
+	if err != nil {
+		t.Fatalf("failed to decode next cursor: %v", err)
+	}
+	if decoded.Price != last.PurchasePrice {
+		t.Fatalf("expected cursor to carry price=%d for sort_by=price, got=%d", last.PurchasePrice, decoded.Price)
+	}
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// ItemRepository is the persistence boundary ItemUsecase depends on.
+type ItemRepository interface {
+	FindAll(ctx context.Context) ([]*entity.Item, error)
+	FindByID(ctx context.Context, id int64) (*entity.Item, error)
+	Create(ctx context.Context, item *entity.Item) (*entity.Item, error)
+	Update(ctx context.Context, item *entity.Item) error
+	Delete(ctx context.Context, id int64) error
+	GetSummaryByCategory(ctx context.Context) (map[string]int, error)
+	// DeleteItems removes every id in a single transaction, reporting the
+	// outcome of each id individually so the usecase can surface partial
+	// failures instead of just an aggregate error.
+	DeleteItems(ctx context.Context, ids []int64) (results []ItemDeleteResult, err error)
+	// DeleteByFilter removes every item matching filter in a single
+	// transaction and reports how many rows were removed.
+	DeleteByFilter(ctx context.Context, filter ItemFilter) (count int, err error)
+	// ListItems runs a keyset query: WHERE (sort_key, id) > (after.sort_key,
+	// after.id), ordered and filtered per input, so pagination cost is
+	// O(limit) regardless of offset. after is nil for the first page.
+	ListItems(ctx context.Context, input ListItemsInput, after *ItemCursor) (items []*entity.Item, hasMore bool, err error)
+}
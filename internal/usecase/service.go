@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 	"strings"
@@ -16,6 +17,23 @@ type ItemUsecase interface {
 	DeleteItem(ctx context.Context, id int64) error
 	GetCategorySummary(ctx context.Context) (*CategorySummary, error)
 	UpdateItem(ctx context.Context, id int64, input UpdateItemInput) (*entity.Item, error)
+	// DeleteItems deletes every id given, in a single repository
+	// transaction, and returns the outcome of each id individually so a
+	// batch can partially fail without losing track of who succeeded.
+	// When any id fails, err is an aggregate (errors.Join) of every per-id
+	// failure; it is nil when every id was deleted.
+	DeleteItems(ctx context.Context, ids []int64) (results []ItemDeleteResult, err error)
+	// DeleteByFilter deletes every item matching filter and returns how
+	// many rows were removed. filter must not be empty unless
+	// filter.AllowAll is set, to avoid accidental full-table wipes.
+	DeleteByFilter(ctx context.Context, filter ItemFilter) (count int, err error)
+	// ListItems returns a keyset-paginated, filtered, and sorted page of
+	// items (see pagination.go for the cursor format).
+	ListItems(ctx context.Context, input ListItemsInput) (*ItemPage, error)
+	// Use registers a hook (see hooks.go) against every *Handler interface
+	// it implements, letting callers bolt on behaviour such as audit
+	// logging or cache invalidation without editing the usecase.
+	Use(hook interface{})
 }
 
 type CreateItemInput struct {
@@ -37,8 +55,40 @@ type CategorySummary struct {
 	Total      int            `json:"total"`
 }
 
+// ItemFilter narrows a bulk-delete operation down to a subset of items. The zero value matches everything, which is why
+// DeleteByFilter refuses it unless AllowAll is explicitly set.
+type ItemFilter struct {
+	Category         string `json:"category"`
+	BrandPrefix      string `json:"brand_prefix"`
+	PurchaseDateFrom string `json:"purchase_date_from"`
+	PurchaseDateTo   string `json:"purchase_date_to"`
+	PriceMin         *int   `json:"price_gte"`
+	PriceMax         *int   `json:"price_lte"`
+	// AllowAll must be set to bypass the empty-filter guard in
+	// DeleteByFilter, making an intentional full wipe explicit.
+	AllowAll bool `json:"allow_all"`
+}
+
+// ItemDeleteResult is the per-id outcome of a DeleteItems call: Error is
+// nil when id was deleted successfully.
+type ItemDeleteResult struct {
+	ID    int64
+	Error error
+}
+
+// IsEmpty reports whether no field besides AllowAll narrows the filter.
+func (f ItemFilter) IsEmpty() bool {
+	return f.Category == "" &&
+		f.BrandPrefix == "" &&
+		f.PurchaseDateFrom == "" &&
+		f.PurchaseDateTo == "" &&
+		f.PriceMin == nil &&
+		f.PriceMax == nil
+}
+
 type itemUsecase struct {
 	itemRepo ItemRepository
+	hooks    hookRegistry
 }
 
 func NewItemUsecase(itemRepo ItemRepository) ItemUsecase {
@@ -72,9 +122,9 @@ func (u *itemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item,
 	return item, nil
 }
 
-func (u *itemUsecase) CreateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error) {
+func (u *itemUsecase) CreateItem(ctx context.Context, input CreateItemInput) (item *entity.Item, err error) {
 	// バリデーションして、新しいエンティティを作成
-	item, err := entity.NewItem(
+	newItem, err := entity.NewItem(
 		input.Name,
 		input.Category,
 		input.Brand,
@@ -85,20 +135,30 @@ func (u *itemUsecase) CreateItem(ctx context.Context, input CreateItemInput) (*e
 		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
 	}
 
-	createdItem, err := u.itemRepo.Create(ctx, item)
+	// pre-hook がエラーを返した場合はリポジトリを呼ばずに中断
+	if err = u.runBeforeCreate(ctx, newItem); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		u.runAfterCreate(ctx, item, &err)
+	}()
+
+	item, err = u.itemRepo.Create(ctx, newItem)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create item: %w", err)
+		err = fmt.Errorf("failed to create item: %w", err)
+		return nil, err
 	}
 
-	return createdItem, nil
+	return item, nil
 }
 
-func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) error {
+func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) (err error) {
 	if id <= 0 {
 		return domainErrors.ErrInvalidInput
 	}
 
-	_, err := u.itemRepo.FindByID(ctx, id)
+	item, err := u.itemRepo.FindByID(ctx, id)
 	if err != nil {
 		if domainErrors.IsNotFoundError(err) {
 			return domainErrors.ErrItemNotFound
@@ -106,9 +166,19 @@ func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) error {
 		return fmt.Errorf("failed to check item existence: %w", err)
 	}
 
-	err = u.itemRepo.Delete(ctx, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete item: %w", err)
+	// pre-hook がエラーを返した場合はリポジトリを呼ばずに中断
+	if err = u.runBeforeDelete(ctx, item); err != nil {
+		return err
+	}
+
+	// post-hook はリポジトリ呼び出しの成否にかかわらず必ず実行する
+	defer func() {
+		u.runAfterDelete(ctx, item, &err)
+	}()
+
+	if err = u.itemRepo.Delete(ctx, id); err != nil {
+		err = fmt.Errorf("failed to delete item: %w", err)
+		return err
 	}
 
 	return nil
@@ -141,7 +211,7 @@ func (u *itemUsecase) GetCategorySummary(ctx context.Context) (*CategorySummary,
 	}, nil
 }
 
-func (u *itemUsecase) UpdateItem(ctx context.Context, id int64, input UpdateItemInput) (*entity.Item, error) {
+func (u *itemUsecase) UpdateItem(ctx context.Context, id int64, input UpdateItemInput) (item *entity.Item, err error) {
 	if id <= 0 {
 		return nil, domainErrors.ErrInvalidInput
 	}
@@ -152,7 +222,7 @@ func (u *itemUsecase) UpdateItem(ctx context.Context, id int64, input UpdateItem
 	}
 
 	// 1. 既存アイテム取得
-	item, err := u.itemRepo.FindByID(ctx, id)
+	item, err = u.itemRepo.FindByID(ctx, id)
 	if err != nil {
 		if domainErrors.IsNotFoundError(err) {
 			return nil, domainErrors.ErrItemNotFound
@@ -202,11 +272,66 @@ func (u *itemUsecase) UpdateItem(ctx context.Context, id int64, input UpdateItem
 	// ★ ここでは item.Validate() を呼ばない
 	//    → 変えたフィールドだけ自前でチェック済み
 
+	// pre-hook がエラーを返した場合はリポジトリを呼ばずに中断
+	if err = u.runBeforeUpdate(ctx, item); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		u.runAfterUpdate(ctx, item, &err)
+	}()
+
 	// 5. DB 更新
-	if err := u.itemRepo.Update(ctx, item); err != nil {
-		return nil, fmt.Errorf("failed to update item: %w", err)
+	if err = u.itemRepo.Update(ctx, item); err != nil {
+		err = fmt.Errorf("failed to update item: %w", err)
+		return nil, err
 	}
 
 	return item, nil
 }
 
+func (u *itemUsecase) DeleteItems(ctx context.Context, ids []int64) (results []ItemDeleteResult, err error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: ids must not be empty", domainErrors.ErrInvalidInput)
+	}
+
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("%w: id must be positive, got %d", domainErrors.ErrInvalidInput, id)
+		}
+	}
+
+	// Checked up front, before the repository is ever called, so a
+	// cancellation that's already landed aborts the whole batch rather
+	// than racing the transaction.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results, err = u.itemRepo.DeleteItems(ctx, ids)
+	if err != nil {
+		return results, fmt.Errorf("failed to delete items: %w", err)
+	}
+
+	var errs []error
+	for _, r := range results {
+		if r.Error != nil {
+			errs = append(errs, fmt.Errorf("id %d: %w", r.ID, r.Error))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func (u *itemUsecase) DeleteByFilter(ctx context.Context, filter ItemFilter) (count int, err error) {
+	if filter.IsEmpty() && !filter.AllowAll {
+		return 0, fmt.Errorf("%w: filter must not be empty; set allow_all to delete every item", domainErrors.ErrInvalidInput)
+	}
+
+	count, err = u.itemRepo.DeleteByFilter(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete items by filter: %w", err)
+	}
+
+	return count, nil
+}
+